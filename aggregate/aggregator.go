@@ -0,0 +1,53 @@
+package aggregate
+
+import (
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+// Aggregator 把一次奖牌榜抓取结果（crawler.Row）转成某个 Tournament 下的 Standing
+// 快照，存入 StandingStore 并打印出相对上一条快照的增量。
+type Aggregator struct {
+	Tournament Tournament
+	Store      StandingStore
+}
+
+// NewAggregator 创建一个绑定了具体赛事和存储的 Aggregator。
+func NewAggregator(tournament Tournament, store StandingStore) *Aggregator {
+	return &Aggregator{Tournament: tournament, Store: store}
+}
+
+// RecordMedalSnapshot 把奖牌榜的行转成 Standing，记录这次快照并返回每个国家的增量。
+func (a *Aggregator) RecordMedalSnapshot(rows []crawler.Row, capturedAt time.Time) ([]Delta, error) {
+	standings := make([]Standing, 0, len(rows))
+	for _, row := range rows {
+		gold, _ := strconv.Atoi(row["gold"])
+		silver, _ := strconv.Atoi(row["silver"])
+		bronze, _ := strconv.Atoi(row["bronze"])
+
+		standings = append(standings, Standing{
+			TournamentID: a.Tournament.ID,
+			Country:      row["country"],
+			CapturedAt:   capturedAt,
+			Gold:         gold,
+			Silver:       silver,
+			Bronze:       bronze,
+			Total:        gold + silver + bronze,
+		})
+	}
+
+	deltas, err := a.Store.SaveSnapshot(standings)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, delta := range deltas {
+		log.Printf("[aggregate] %s %s: +%d金 +%d银 +%d铜", a.Tournament.ID, delta.Country,
+			delta.GoldGained, delta.SilverGained, delta.BronzeGained)
+	}
+
+	return deltas, nil
+}
@@ -0,0 +1,36 @@
+package aggregate
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+func TestAggregator_RecordMedalSnapshot_ComputesDelta(t *testing.T) {
+	tournament := Tournament{ID: "paris2024", Name: "Paris 2024"}
+	store := NewJSONStandingStore(filepath.Join(t.TempDir(), "standings_history.json"))
+	aggregator := NewAggregator(tournament, store)
+
+	first := []crawler.Row{{"country": "USA", "gold": "10", "silver": "5", "bronze": "3"}}
+	deltas, err := aggregator.RecordMedalSnapshot(first, time.Unix(1, 0))
+	if err != nil {
+		t.Fatalf("RecordMedalSnapshot returned error: %v", err)
+	}
+	if len(deltas) != 1 || deltas[0].GoldGained != 10 {
+		t.Fatalf("expected initial snapshot to count fully as gained, got %+v", deltas)
+	}
+
+	second := []crawler.Row{{"country": "USA", "gold": "13", "silver": "5", "bronze": "4"}}
+	deltas, err = aggregator.RecordMedalSnapshot(second, time.Unix(2, 0))
+	if err != nil {
+		t.Fatalf("RecordMedalSnapshot returned error: %v", err)
+	}
+	if len(deltas) != 1 {
+		t.Fatalf("expected one delta, got %+v", deltas)
+	}
+	if deltas[0].GoldGained != 3 || deltas[0].SilverGained != 0 || deltas[0].BronzeGained != 1 {
+		t.Errorf("expected +3 gold, +0 silver, +1 bronze, got %+v", deltas[0])
+	}
+}
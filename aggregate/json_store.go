@@ -0,0 +1,101 @@
+package aggregate
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// JSONStandingStore 把奖牌榜时间序列追加写入 standings_history.json。
+type JSONStandingStore struct {
+	Path string
+}
+
+// NewJSONStandingStore 创建一个写入 standings_history.json 的 JSONStandingStore。
+func NewJSONStandingStore(path string) JSONStandingStore {
+	if path == "" {
+		path = "standings_history.json"
+	}
+	return JSONStandingStore{Path: path}
+}
+
+// record 是文件里的一行：某次快照，以及相对上一条同国家快照的增量。
+type record struct {
+	Standing Standing `json:"standing"`
+	Delta    Delta    `json:"delta"`
+}
+
+// SaveSnapshot 读出已有历史，按 (TournamentID, Country) 找到每个国家的上一条快照
+// 算出增量，把新快照连同增量一起追加写回文件。
+func (s JSONStandingStore) SaveSnapshot(standings []Standing) ([]Delta, error) {
+	records, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	latest := make(map[string]Standing, len(records))
+	for _, rec := range records {
+		latest[standingKey(rec.Standing.TournamentID, rec.Standing.Country)] = rec.Standing
+	}
+
+	deltas := make([]Delta, 0, len(standings))
+	for _, standing := range standings {
+		key := standingKey(standing.TournamentID, standing.Country)
+		prev, hadPrev := latest[key]
+
+		delta := Delta{
+			TournamentID: standing.TournamentID,
+			Country:      standing.Country,
+			CapturedAt:   standing.CapturedAt,
+		}
+		if hadPrev {
+			delta.GoldGained = standing.Gold - prev.Gold
+			delta.SilverGained = standing.Silver - prev.Silver
+			delta.BronzeGained = standing.Bronze - prev.Bronze
+		} else {
+			delta.GoldGained = standing.Gold
+			delta.SilverGained = standing.Silver
+			delta.BronzeGained = standing.Bronze
+		}
+
+		records = append(records, record{Standing: standing, Delta: delta})
+		deltas = append(deltas, delta)
+		latest[key] = standing
+	}
+
+	if err := s.save(records); err != nil {
+		return nil, err
+	}
+	return deltas, nil
+}
+
+func standingKey(tournamentID, country string) string {
+	return tournamentID + "|" + country
+}
+
+func (s JSONStandingStore) load() ([]record, error) {
+	data, err := os.ReadFile(s.Path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var records []record
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (s JSONStandingStore) save(records []record) error {
+	file, err := os.Create(s.Path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(records)
+}
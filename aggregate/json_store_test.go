@@ -0,0 +1,36 @@
+package aggregate
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestJSONStandingStore_SaveSnapshot_RoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "standings_history.json")
+	store := NewJSONStandingStore(path)
+
+	first := []Standing{{TournamentID: "paris2024", Country: "USA", CapturedAt: time.Unix(1, 0), Gold: 10}}
+	if _, err := store.SaveSnapshot(first); err != nil {
+		t.Fatalf("SaveSnapshot returned error: %v", err)
+	}
+
+	second := []Standing{{TournamentID: "paris2024", Country: "USA", CapturedAt: time.Unix(2, 0), Gold: 12}}
+	deltas, err := store.SaveSnapshot(second)
+	if err != nil {
+		t.Fatalf("SaveSnapshot returned error: %v", err)
+	}
+	if len(deltas) != 1 || deltas[0].GoldGained != 2 {
+		t.Fatalf("expected +2 gold delta against the prior snapshot, got %+v", deltas)
+	}
+
+	// 重新打开同一个文件的 Store，确认两次快照都持久化下来了，而不是只保留最新一份。
+	reopened := NewJSONStandingStore(path)
+	records, err := reopened.load()
+	if err != nil {
+		t.Fatalf("load returned error: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 persisted records, got %d", len(records))
+	}
+}
@@ -0,0 +1,44 @@
+// Package aggregate 在 MedalData/MatchData 之上加一层赛事建模：Tournament/Sport
+// 描述赛事本身，Standing 把每次抓到的奖牌榜存成时间序列快照，而不是只保留最新一份，
+// 这样才能算出相邻两次快照之间的奖牌增量、画出奖牌榜随赛程推进的变化曲线。
+package aggregate
+
+import "time"
+
+// Tournament 对应 League/LeagueSeason 建模里的 "一届赛事"，例如 Paris2024。
+// IsCup 和 CrossesSeasons 借用足球联赛建模里的概念：IsCup 表示淘汰赛制赛事，
+// CrossesSeasons 表示赛程跨年，奥运会这种单届赛事通常两者都是 false。
+type Tournament struct {
+	ID             string `xorm:"pk varchar(32)" json:"id"`
+	Name           string `xorm:"varchar(128)" json:"name"`
+	StartDate      string `xorm:"varchar(10)" json:"start_date"`
+	EndDate        string `xorm:"varchar(10)" json:"end_date"`
+	IsCup          bool   `xorm:"bool" json:"is_cup"`
+	CrossesSeasons bool   `xorm:"bool" json:"crosses_seasons"`
+}
+
+// Standing 是某个时间点的一条奖牌榜快照，(TournamentID, Country, CapturedAt) 唯一，
+// 多次抓取的结果在这里按时间堆叠成时间序列，而不是互相覆盖。
+type Standing struct {
+	ID           int64     `xorm:"pk autoincr" json:"-"`
+	TournamentID string    `xorm:"varchar(32) notnull unique(UQE_standing)" json:"tournament_id"`
+	Country      string    `xorm:"varchar(64) notnull unique(UQE_standing)" json:"country"`
+	CapturedAt   time.Time `xorm:"notnull unique(UQE_standing)" json:"captured_at"`
+	Gold         int       `xorm:"int" json:"gold"`
+	Silver       int       `xorm:"int" json:"silver"`
+	Bronze       int       `xorm:"int" json:"bronze"`
+	Total        int       `xorm:"int" json:"total"`
+}
+
+// TableName 指定 Standing 对应的表名。
+func (Standing) TableName() string { return "standings_history" }
+
+// Delta 是同一个国家相邻两次快照之间的奖牌增量。
+type Delta struct {
+	TournamentID string    `json:"tournament_id"`
+	Country      string    `json:"country"`
+	CapturedAt   time.Time `json:"captured_at"`
+	GoldGained   int       `json:"gold_gained"`
+	SilverGained int       `json:"silver_gained"`
+	BronzeGained int       `json:"bronze_gained"`
+}
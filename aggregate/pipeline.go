@@ -0,0 +1,33 @@
+package aggregate
+
+import (
+	"time"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+// Pipeline 包一层聚合逻辑在 inner Pipeline 外面：照常把行数据交给 inner 保存，
+// 额外把奖牌榜的行喂给 Aggregator，每次保存记一条 Standing 快照，堆叠成时间序列。
+type Pipeline struct {
+	Inner      crawler.Pipeline
+	Aggregator *Aggregator
+}
+
+// NewPipeline 创建一个带奖牌榜聚合的 Pipeline。
+func NewPipeline(inner crawler.Pipeline, aggregator *Aggregator) Pipeline {
+	return Pipeline{Inner: inner, Aggregator: aggregator}
+}
+
+// Save 实现 crawler.Pipeline：先照常保存，再把奖牌榜数据记一条 Standing 快照。
+func (p Pipeline) Save(outputName string, rows []crawler.Row) error {
+	if err := p.Inner.Save(outputName, rows); err != nil {
+		return err
+	}
+
+	if outputName != "medal_data.json" {
+		return nil
+	}
+
+	_, err := p.Aggregator.RecordMedalSnapshot(rows, time.Now())
+	return err
+}
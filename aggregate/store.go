@@ -0,0 +1,8 @@
+package aggregate
+
+// StandingStore 持久化奖牌榜时间序列，并基于上一条快照算出每个国家的增量。
+// 增量按相邻两次快照计算，不按自然日汇总。
+type StandingStore interface {
+	// SaveSnapshot 追加一批同一时间点的 Standing，并返回相对上一条快照的增量。
+	SaveSnapshot(standings []Standing) ([]Delta, error)
+}
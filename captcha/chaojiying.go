@@ -0,0 +1,73 @@
+package captcha
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const defaultChaoJiYingEndpoint = "http://upload.chaojiying.net/Upload/Processing.php"
+
+// ChaoJiYingSolver 是参考实现：把 base64 编码的图片连同账号信息 POST 给
+// 超级鹰这类打码平台，返回 {err_no, pic_str} 形式的识别结果。
+type ChaoJiYingSolver struct {
+	Username string
+	Password string
+	SoftID   string
+	Endpoint string
+	Client   *http.Client
+}
+
+// NewChaoJiYingSolver 创建一个使用默认打码平台地址和 30 秒超时的 ChaoJiYingSolver。
+func NewChaoJiYingSolver(username, password, softID string) *ChaoJiYingSolver {
+	return &ChaoJiYingSolver{
+		Username: username,
+		Password: password,
+		SoftID:   softID,
+		Endpoint: defaultChaoJiYingEndpoint,
+		Client:   &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type chaoJiYingResponse struct {
+	ErrNo  int    `json:"err_no"`
+	ErrStr string `json:"err_str"`
+	PicStr string `json:"pic_str"`
+}
+
+// Solve 把验证码图片 POST 给打码平台并返回识别出的答案。
+func (s *ChaoJiYingSolver) Solve(ctx context.Context, image []byte) (string, error) {
+	form := url.Values{
+		"user":        {s.Username},
+		"pass":        {s.Password},
+		"softid":      {s.SoftID},
+		"file_base64": {base64.StdEncoding.EncodeToString(image)},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	var result chaoJiYingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return "", err
+	}
+	if result.ErrNo != 0 {
+		return "", fmt.Errorf("captcha: 打码平台返回错误 err_no=%d err_str=%s", result.ErrNo, result.ErrStr)
+	}
+
+	return result.PicStr, nil
+}
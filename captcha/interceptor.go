@@ -0,0 +1,81 @@
+package captcha
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/chromedp/chromedp"
+)
+
+const defaultGracePeriod = 5 * time.Second
+
+// Config 声明如何在页面上定位挑战、截取验证码图片以及回填答案。
+type Config struct {
+	// ChallengeSelector 是挑战页面上验证码图片的选择器，用于截图喂给 Solver。
+	ChallengeSelector string
+	// AnswerInputSelector 是填写识别结果的输入框选择器。
+	AnswerInputSelector string
+	// SubmitSelector 是提交按钮的选择器，留空表示回车/输入后无需额外点击。
+	SubmitSelector string
+	// GracePeriod 是等待 readySelector 出现的宽限期，未设置时使用 5 秒。
+	// 正常页面的表格要等 AJAX 渲染完才出现，这段时间内不应被误判为挑战页面。
+	GracePeriod time.Duration
+}
+
+// Interceptor 在导航到一个页面之后，判断是否命中了反爬挑战，并在命中时调用 Solver
+// 识别验证码、把答案填回页面，直到目标选择器出现为止。
+type Interceptor struct {
+	Solver Solver
+	Config Config
+}
+
+// NewInterceptor 创建一个绑定了具体 Solver 和页面规则的 Interceptor。
+func NewInterceptor(solver Solver, cfg Config) *Interceptor {
+	return &Interceptor{Solver: solver, Config: cfg}
+}
+
+// HandleIfChallenged 像 chromedp.WaitReady 一样，在宽限期内轮询 readySelector 是否
+// 出现；出现说明页面正常渲染，直接返回。只有宽限期耗尽仍未出现时，才认为命中了挑战
+// 页面：截取 ChallengeSelector 的图片交给 Solver 识别，把答案填回 AnswerInputSelector，
+// 需要的话点击 SubmitSelector，最后再等待 readySelector 就绪。
+func (i *Interceptor) HandleIfChallenged(ctx context.Context, readySelector string) error {
+	grace := i.Config.GracePeriod
+	if grace <= 0 {
+		grace = defaultGracePeriod
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, grace)
+	err := chromedp.Run(waitCtx, chromedp.WaitReady(readySelector))
+	cancel()
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	// 宽限期内 readySelector 始终没有出现，视为命中了挑战页面。
+	if i.Config.ChallengeSelector == "" {
+		return fmt.Errorf("captcha: %s 在 %s 内未就绪，且未配置挑战检测选择器", readySelector, grace)
+	}
+
+	var image []byte
+	if err := chromedp.Run(ctx, chromedp.Screenshot(i.Config.ChallengeSelector, &image, chromedp.NodeVisible)); err != nil {
+		return fmt.Errorf("captcha: 截取挑战图片失败: %w", err)
+	}
+
+	answer, err := i.Solver.Solve(ctx, image)
+	if err != nil {
+		return fmt.Errorf("captcha: 识别失败: %w", err)
+	}
+
+	actions := []chromedp.Action{chromedp.SendKeys(i.Config.AnswerInputSelector, answer)}
+	if i.Config.SubmitSelector != "" {
+		actions = append(actions, chromedp.Click(i.Config.SubmitSelector))
+	}
+	actions = append(actions, chromedp.WaitReady(readySelector))
+
+	return chromedp.Run(ctx, actions...)
+}
@@ -0,0 +1,25 @@
+// Package captcha 处理反爬挑战页面：检测页面是否被拦截，把挑战图片交给可插拔的
+// CaptchaSolver 识别，再把答案填回页面。
+package captcha
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrNotConfigured 是 NoopSolver 的固定返回错误，提示用户需要接入真实的识别服务。
+var ErrNotConfigured = errors.New("captcha: 未配置验证码识别服务")
+
+// Solver 识别一张验证码图片并返回答案，不同供应商各自实现。
+type Solver interface {
+	Solve(ctx context.Context, image []byte) (string, error)
+}
+
+// NoopSolver 是默认实现：遇到验证码时直接报错，不做任何识别。
+// 用户需要显式接入一个真正的 Solver（例如 ChaoJiYingSolver）才能自动过验证码。
+type NoopSolver struct{}
+
+// Solve 始终返回 ErrNotConfigured。
+func (NoopSolver) Solve(ctx context.Context, image []byte) (string, error) {
+	return "", ErrNotConfigured
+}
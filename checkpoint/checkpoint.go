@@ -0,0 +1,96 @@
+// Package checkpoint 记录每个抓取目标上一次成功结果的内容哈希，
+// 让爬虫可以在内容没有变化时跳过重复抓取，定期运行时只产出真正的增量。
+package checkpoint
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+var bucketName = []byte("checkpoints")
+
+// Record 是某个抓取目标的一次快照：内容哈希、抓取时间，以及当时提取到的行，
+// 后者用于下次变化时计算 diff。
+type Record struct {
+	Hash      string        `json:"hash"`
+	FetchedAt time.Time     `json:"fetched_at"`
+	Rows      []crawler.Row `json:"rows"`
+}
+
+// Store 是基于 BoltDB 的检查点存储，key 是抓取目标的 outputName。
+type Store struct {
+	db *bbolt.DB
+}
+
+// Open 打开（或创建）一个 BoltDB 文件作为检查点存储。
+func Open(path string) (*Store, error) {
+	db, err := bbolt.Open(path, 0o600, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(bucketName)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+
+	return &Store{db: db}, nil
+}
+
+// Close 关闭底层的 BoltDB 文件。
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Get 读取 key 对应的检查点，found 为 false 表示此前没有记录过。
+func (s *Store) Get(key string) (Record, bool, error) {
+	var rec Record
+	found := false
+
+	err := s.db.View(func(tx *bbolt.Tx) error {
+		value := tx.Bucket(bucketName).Get([]byte(key))
+		if value == nil {
+			return nil
+		}
+		found = true
+		return json.Unmarshal(value, &rec)
+	})
+	if err != nil {
+		return Record{}, false, err
+	}
+	return rec, found, nil
+}
+
+// Put 写入 key 对应的检查点，覆盖上一次的记录。
+func (s *Store) Put(key string, rec Record) error {
+	value, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	return s.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(bucketName).Put([]byte(key), value)
+	})
+}
+
+// HashRows 对一批行做稳定的 SHA-256 哈希，用于判断内容是否发生变化。
+func HashRows(rows []crawler.Row) (string, error) {
+	// rows 的顺序来自页面的 DOM 顺序，同一个页面两次抓取顺序一致，
+	// 所以直接序列化即可得到稳定的哈希，不需要额外排序。
+	encoded, err := json.Marshal(rows)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,92 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+// Diff 描述两次抓取之间，按 KeyFunc 匹配到的行发生了什么变化。
+type Diff struct {
+	Added   []crawler.Row `json:"added"`
+	Removed []crawler.Row `json:"removed"`
+	Changed []ChangedRow  `json:"changed"`
+}
+
+// ChangedRow 是同一个 key 在新旧两次抓取中内容不同的行。
+type ChangedRow struct {
+	Before crawler.Row `json:"before"`
+	After  crawler.Row `json:"after"`
+}
+
+// IsEmpty 判断这次 diff 是否没有任何变化。
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// KeyFunc 从一行里取出用于匹配新旧记录的业务主键，例如奖牌榜的国家、
+// 赛程的 time+name。keyFn 为 nil 时退化为按整行内容匹配。
+type KeyFunc func(crawler.Row) string
+
+// ComputeDiff 按 keyFn 把 oldRows/newRows 匹配起来，找出新增、删除和发生变化的行。
+func ComputeDiff(oldRows, newRows []crawler.Row, keyFn KeyFunc) Diff {
+	if keyFn == nil {
+		keyFn = func(row crawler.Row) string {
+			encoded, _ := json.Marshal(row)
+			return string(encoded)
+		}
+	}
+
+	oldByKey := make(map[string]crawler.Row, len(oldRows))
+	for _, row := range oldRows {
+		oldByKey[keyFn(row)] = row
+	}
+
+	newByKey := make(map[string]crawler.Row, len(newRows))
+	for _, row := range newRows {
+		newByKey[keyFn(row)] = row
+	}
+
+	var diff Diff
+	for key, newRow := range newByKey {
+		oldRow, existed := oldByKey[key]
+		if !existed {
+			diff.Added = append(diff.Added, newRow)
+			continue
+		}
+		if !reflect.DeepEqual(oldRow, newRow) {
+			diff.Changed = append(diff.Changed, ChangedRow{Before: oldRow, After: newRow})
+		}
+	}
+	for key, oldRow := range oldByKey {
+		if _, stillExists := newByKey[key]; !stillExists {
+			diff.Removed = append(diff.Removed, oldRow)
+		}
+	}
+
+	return diff
+}
+
+// WriteDiffFile 把 diff 写到 "<outputName 去掉 .json>_diff.json"。
+func WriteDiffFile(outputName string, diff Diff) error {
+	file, err := os.Create(diffFileName(outputName))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(diff)
+}
+
+func diffFileName(outputName string) string {
+	const suffix = ".json"
+	base := outputName
+	if len(base) > len(suffix) && base[len(base)-len(suffix):] == suffix {
+		base = base[:len(base)-len(suffix)]
+	}
+	return base + "_diff.json"
+}
@@ -0,0 +1,41 @@
+package checkpoint
+
+import (
+	"testing"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+func byCountry(row crawler.Row) string { return row["country"] }
+
+func TestComputeDiff_AddedRemovedChanged(t *testing.T) {
+	oldRows := []crawler.Row{
+		{"country": "USA", "gold": "39"},
+		{"country": "JPN", "gold": "20"},
+	}
+	newRows := []crawler.Row{
+		{"country": "USA", "gold": "40"}, // changed
+		{"country": "CHN", "gold": "40"}, // added
+	}
+
+	diff := ComputeDiff(oldRows, newRows, byCountry)
+
+	if len(diff.Added) != 1 || diff.Added[0]["country"] != "CHN" {
+		t.Errorf("expected CHN added, got %+v", diff.Added)
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0]["country"] != "JPN" {
+		t.Errorf("expected JPN removed, got %+v", diff.Removed)
+	}
+	if len(diff.Changed) != 1 || diff.Changed[0].After["gold"] != "40" {
+		t.Errorf("expected USA changed to gold=40, got %+v", diff.Changed)
+	}
+}
+
+func TestComputeDiff_NoChangesIsEmpty(t *testing.T) {
+	rows := []crawler.Row{{"country": "USA", "gold": "40"}}
+	diff := ComputeDiff(rows, rows, byCountry)
+
+	if !diff.IsEmpty() {
+		t.Errorf("expected empty diff, got %+v", diff)
+	}
+}
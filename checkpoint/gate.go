@@ -0,0 +1,27 @@
+package checkpoint
+
+import "time"
+
+// Gate 实现 crawler.FreshnessChecker：只要上一次检查点还在 TTL 有效期内，就认为目标
+// 是新鲜的，Engine/Scheduler 据此在调用 Fetcher 之前跳过整个任务，而不只是跳过保存。
+type Gate struct {
+	Store *Store
+	TTL   time.Duration
+}
+
+// NewGate 创建一个按 ttl 判断新鲜度的 Gate。
+func NewGate(store *Store, ttl time.Duration) Gate {
+	return Gate{Store: store, TTL: ttl}
+}
+
+// IsFresh 返回 outputName 上一次检查点是否还在 TTL 有效期内。
+func (g Gate) IsFresh(outputName string) (bool, error) {
+	rec, found, err := g.Store.Get(outputName)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return false, nil
+	}
+	return time.Since(rec.FetchedAt) < g.TTL, nil
+}
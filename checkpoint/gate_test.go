@@ -0,0 +1,69 @@
+package checkpoint
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+func openTestStore(t *testing.T) *Store {
+	t.Helper()
+	store, err := Open(filepath.Join(t.TempDir(), "checkpoint.bolt"))
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	t.Cleanup(func() { store.Close() })
+	return store
+}
+
+func TestGate_IsFresh_RespectsTTL(t *testing.T) {
+	store := openTestStore(t)
+	gate := NewGate(store, time.Hour)
+
+	fresh, err := gate.IsFresh("medal_data.json")
+	if err != nil {
+		t.Fatalf("IsFresh returned error: %v", err)
+	}
+	if fresh {
+		t.Errorf("expected no checkpoint to be stale, got fresh=true")
+	}
+
+	if err := store.Put("medal_data.json", Record{
+		Hash:      "abc",
+		FetchedAt: time.Now(),
+		Rows:      []crawler.Row{{"country": "USA"}},
+	}); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+
+	fresh, err = gate.IsFresh("medal_data.json")
+	if err != nil {
+		t.Fatalf("IsFresh returned error: %v", err)
+	}
+	if !fresh {
+		t.Errorf("expected checkpoint within TTL to be fresh")
+	}
+}
+
+func TestGate_IsFresh_ExpiredTTL(t *testing.T) {
+	store := openTestStore(t)
+	gate := NewGate(store, time.Hour)
+
+	if err := store.Put("medal_data.json", Record{
+		Hash:      "abc",
+		FetchedAt: time.Now().Add(-2 * time.Hour),
+		Rows:      []crawler.Row{{"country": "USA"}},
+	}); err != nil {
+		t.Fatalf("Put returned error: %v", err)
+	}
+
+	fresh, err := gate.IsFresh("medal_data.json")
+	if err != nil {
+		t.Fatalf("IsFresh returned error: %v", err)
+	}
+	if fresh {
+		t.Errorf("expected checkpoint past TTL to be stale")
+	}
+}
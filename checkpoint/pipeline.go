@@ -0,0 +1,48 @@
+package checkpoint
+
+import (
+	"time"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+// Pipeline 包一层检查点记录在 inner Pipeline 外面：内容较上一次变化时先写一份 diff
+// 文件记录新增/删除/变化的行，再照常保存，并刷新检查点供 Gate 判断下次是否需要抓取。
+type Pipeline struct {
+	Inner   crawler.Pipeline
+	Store   *Store
+	KeyFunc KeyFunc
+}
+
+// NewPipeline 创建一个带检查点记录的 Pipeline，key 对应 outputName，value 是提取到的行。
+func NewPipeline(inner crawler.Pipeline, store *Store, keyFn KeyFunc) Pipeline {
+	return Pipeline{Inner: inner, Store: store, KeyFunc: keyFn}
+}
+
+// Save 实现 crawler.Pipeline：内容变化时先写 diff，再委托给 inner 保存并刷新检查点。
+func (p Pipeline) Save(outputName string, rows []crawler.Row) error {
+	hash, err := HashRows(rows)
+	if err != nil {
+		return err
+	}
+
+	prev, found, err := p.Store.Get(outputName)
+	if err != nil {
+		return err
+	}
+
+	if found && prev.Hash != hash {
+		diff := ComputeDiff(prev.Rows, rows, p.KeyFunc)
+		if !diff.IsEmpty() {
+			if err := WriteDiffFile(outputName, diff); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := p.Inner.Save(outputName, rows); err != nil {
+		return err
+	}
+
+	return p.Store.Put(outputName, Record{Hash: hash, FetchedAt: time.Now(), Rows: rows})
+}
@@ -0,0 +1,60 @@
+package crawler
+
+import (
+	"context"
+	"fmt"
+	"log"
+)
+
+// Engine 是整个框架的运行入口：对每个 Spider 的每个 Request 依次执行
+// 抓取 -> 解析 -> 落地，彼此之间通过接口解耦，便于替换或在测试里用 fixture 代替真实请求。
+type Engine struct {
+	Fetcher  Fetcher
+	Pipeline Pipeline
+
+	// Freshness 在配置后，会在抓取前问一遍目标是否仍在检查点有效期内，
+	// 为 nil 表示不做这个检查，每次都抓取。
+	Freshness FreshnessChecker
+}
+
+// NewEngine 用给定的 Fetcher 和 Pipeline 创建一个 Engine，Freshness 默认为 nil。
+func NewEngine(fetcher Fetcher, pipeline Pipeline) *Engine {
+	return &Engine{Fetcher: fetcher, Pipeline: pipeline}
+}
+
+// Run 依次执行 spider 声明的所有请求。单个请求失败不会中断其余请求，
+// 与原先 fetchMatchData 里「某一天失败就 continue」的行为保持一致。
+func (e *Engine) Run(ctx context.Context, spider Spider) error {
+	for _, req := range spider.Requests() {
+		if e.Freshness != nil {
+			fresh, err := e.Freshness.IsFresh(req.OutputName)
+			if err != nil {
+				log.Printf("[%s] 检查点查询失败 %s: %v", spider.Name(), req.OutputName, err)
+			} else if fresh {
+				log.Printf("[%s] %s 仍在检查点有效期内，跳过抓取", spider.Name(), req.OutputName)
+				continue
+			}
+		}
+
+		htmlContent, err := e.Fetcher.Fetch(ctx, req.URL, req.WaitSelector)
+		if err != nil {
+			log.Printf("[%s] 抓取失败 %s: %v", spider.Name(), req.URL, err)
+			continue
+		}
+
+		rows, err := ParseRows(htmlContent, spider.RowSelector(), spider.Fields())
+		if err != nil {
+			log.Printf("[%s] 解析失败 %s: %v", spider.Name(), req.URL, err)
+			continue
+		}
+
+		if err := e.Pipeline.Save(req.OutputName, rows); err != nil {
+			log.Printf("[%s] 保存失败 %s: %v", spider.Name(), req.OutputName, err)
+			continue
+		}
+
+		fmt.Printf("数据已成功保存到 %s 文件中\n", req.OutputName)
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package crawler
+
+import (
+	"context"
+	"time"
+
+	"github.com/chromedp/chromedp"
+)
+
+// Fetcher 负责把一个 URL 变成页面的 HTML 内容，屏蔽具体是用浏览器还是普通 HTTP 请求抓取。
+type Fetcher interface {
+	Fetch(ctx context.Context, url string, waitSelector string) (string, error)
+}
+
+// ChromedpFetcher 使用无头浏览器抓取需要等待 JS 渲染的页面，对应原先的 chromedp.Run 调用。
+type ChromedpFetcher struct {
+	Timeout time.Duration
+}
+
+// NewChromedpFetcher 创建一个带默认超时的 ChromedpFetcher。
+func NewChromedpFetcher(timeout time.Duration) *ChromedpFetcher {
+	if timeout <= 0 {
+		timeout = 60 * time.Second
+	}
+	return &ChromedpFetcher{Timeout: timeout}
+}
+
+// Fetch 创建 chromedp 上下文，导航到 url，等待 waitSelector 就绪后返回 body 的 HTML。
+func (f *ChromedpFetcher) Fetch(ctx context.Context, url string, waitSelector string) (string, error) {
+	ctx, cancel := chromedp.NewContext(ctx)
+	defer cancel()
+
+	ctx, cancel = context.WithTimeout(ctx, f.Timeout)
+	defer cancel()
+
+	var htmlContent string
+	err := chromedp.Run(ctx,
+		chromedp.Navigate(url),
+		chromedp.WaitReady(waitSelector),
+		chromedp.OuterHTML("body", &htmlContent),
+	)
+	if err != nil {
+		return "", err
+	}
+	return htmlContent, nil
+}
@@ -0,0 +1,44 @@
+package crawler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+// HTTPFetcher 用普通 HTTP 请求抓取静态页面，不经过浏览器、不等待 JS 渲染。
+// CCTV 的奖牌榜和赛程页都依赖 AJAX 填充表格，必须用 ChromedpFetcher；
+// HTTPFetcher 留给将来接入的纯服务端渲染站点，waitSelector 对它没有意义，
+// 仅为实现 Fetcher 接口而保留参数位置。
+type HTTPFetcher struct {
+	Client *http.Client
+}
+
+// NewHTTPFetcher 创建一个带默认超时的 HTTPFetcher。
+func NewHTTPFetcher(timeout time.Duration) *HTTPFetcher {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &HTTPFetcher{Client: &http.Client{Timeout: timeout}}
+}
+
+// Fetch 发起 GET 请求并返回响应体的文本内容。
+func (f *HTTPFetcher) Fetch(ctx context.Context, url string, waitSelector string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := f.Client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
@@ -0,0 +1,26 @@
+package crawler
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPFetcher_Fetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("<html><body>ok</body></html>"))
+	}))
+	defer server.Close()
+
+	fetcher := NewHTTPFetcher(0)
+	html, err := fetcher.Fetch(context.Background(), server.URL, "")
+	if err != nil {
+		t.Fatalf("Fetch returned error: %v", err)
+	}
+
+	want := "<html><body>ok</body></html>"
+	if html != want {
+		t.Errorf("Fetch() = %q, want %q", html, want)
+	}
+}
@@ -0,0 +1,8 @@
+package crawler
+
+// FreshnessChecker 判断某个输出目标当前是否还在有效期内，不需要重新抓取。
+// Engine/Scheduler 在调用 Fetcher 之前先问一遍，从源头上避免对着同一个
+// 检查点窗口内的页面反复发请求。
+type FreshnessChecker interface {
+	IsFresh(outputName string) (bool, error)
+}
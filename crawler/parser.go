@@ -0,0 +1,51 @@
+package crawler
+
+import (
+	"strings"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Row 是一行抓取结果，字段名取自 FieldRule.Name。
+type Row map[string]string
+
+// ParseRows 按 Spider 声明的 RowSelector 和 Fields 规则，从 HTML 中提取行数据。
+// 这是原先 extractMedalData / extractMatchData 共用的通用实现：site-specific 的部分
+// 全部下放到 Spider 的声明式规则里，引擎本身不再关心某个站点的表格长什么样。
+func ParseRows(htmlContent string, rowSelector string, fields []FieldRule) ([]Row, error) {
+	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []Row
+	doc.Find(rowSelector).Each(func(i int, sel *goquery.Selection) {
+		row := make(Row, len(fields))
+		for _, f := range fields {
+			target := sel
+			if f.Selector != "" {
+				target = sel.Find(f.Selector)
+			}
+
+			var value string
+			if f.Attr != "" {
+				value, _ = target.Attr(f.Attr)
+			} else {
+				value = target.Text()
+			}
+			value = strings.TrimSpace(value)
+
+			if f.Transform != nil {
+				value = f.Transform(value)
+			}
+			row[f.Name] = value
+		}
+		// 与原实现一致：首字段为空视为表头/空行，跳过。
+		if len(fields) > 0 && row[fields[0].Name] == "" {
+			return
+		}
+		rows = append(rows, row)
+	})
+
+	return rows, nil
+}
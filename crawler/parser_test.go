@@ -0,0 +1,79 @@
+package crawler
+
+import (
+	"strings"
+	"testing"
+)
+
+const medalFixture = `
+<table id="medal_list1">
+	<tr><th>排名</th><th class="country"></th><th>金牌</th><th>银牌</th><th>铜牌</th><th>总数</th></tr>
+	<tr><td>1</td><td class="country"><a href="/country?countryid=USA&tab=1">美国</a></td><td>40</td><td>44</td><td>42</td><td>126</td></tr>
+	<tr><td>2</td><td class="country"><a href="/country?countryid=CHN&tab=1">中国</a></td><td>40</td><td>27</td><td>24</td><td>91</td></tr>
+</table>
+`
+
+func medalFields() []FieldRule {
+	return []FieldRule{
+		{Name: "rank", Selector: "td:nth-child(1)"},
+		{Name: "country", Selector: "td.country a", Attr: "href", Transform: extractCountryIDForTest},
+		{Name: "gold", Selector: "td:nth-child(3)"},
+		{Name: "silver", Selector: "td:nth-child(4)"},
+		{Name: "bronze", Selector: "td:nth-child(5)"},
+		{Name: "total", Selector: "td:nth-child(6)"},
+	}
+}
+
+// extractCountryIDForTest 镜像 spiders.MedalSpider 里的 extractCountryID，
+// 避免 crawler 包为了测试反过来依赖 crawler/spiders。
+func extractCountryIDForTest(link string) string {
+	parts := strings.Split(link, "countryid=")
+	if len(parts) < 2 {
+		return ""
+	}
+	return strings.Split(parts[1], "&")[0]
+}
+
+func TestParseRows_ExtractsDeclaredFields(t *testing.T) {
+	rows, err := ParseRows(medalFixture, "#medal_list1 tr", medalFields())
+	if err != nil {
+		t.Fatalf("ParseRows returned error: %v", err)
+	}
+
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows (header skipped), got %d: %+v", len(rows), rows)
+	}
+
+	want := Row{"rank": "1", "country": "USA", "gold": "40", "silver": "44", "bronze": "42", "total": "126"}
+	for k, v := range want {
+		if rows[0][k] != v {
+			t.Errorf("rows[0][%q] = %q, want %q", k, rows[0][k], v)
+		}
+	}
+	if rows[1]["country"] != "CHN" {
+		t.Errorf("rows[1][country] = %q, want CHN", rows[1]["country"])
+	}
+}
+
+func TestParseRows_SkipsHeaderRow(t *testing.T) {
+	rows, err := ParseRows(medalFixture, "#medal_list1 tr", medalFields())
+	if err != nil {
+		t.Fatalf("ParseRows returned error: %v", err)
+	}
+
+	for _, row := range rows {
+		if row["rank"] == "" {
+			t.Errorf("expected header row (empty rank) to be skipped, got %+v", row)
+		}
+	}
+}
+
+func TestParseRows_NoMatches(t *testing.T) {
+	rows, err := ParseRows(medalFixture, "#does_not_exist tr", medalFields())
+	if err != nil {
+		t.Fatalf("ParseRows returned error: %v", err)
+	}
+	if len(rows) != 0 {
+		t.Fatalf("expected no rows, got %d", len(rows))
+	}
+}
@@ -0,0 +1,27 @@
+package crawler
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Pipeline 负责把一批抓取结果落地，屏蔽具体是写 JSON 文件、CSV 还是入库。
+type Pipeline interface {
+	Save(outputName string, rows []Row) error
+}
+
+// JSONPipeline 将结果写成格式化的 JSON 文件，对应原先的 saveToJSON。
+type JSONPipeline struct{}
+
+// Save 把 rows 编码为 JSON 并写入 outputName 指定的文件。
+func (JSONPipeline) Save(outputName string, rows []Row) error {
+	file, err := os.Create(outputName)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(rows)
+}
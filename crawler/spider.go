@@ -0,0 +1,40 @@
+package crawler
+
+import "time"
+
+// Request 描述一次抓取任务：目标地址、等待就绪的选择器，以及结果落地时使用的名称
+// （交给 Pipeline 时作为文件名/表名等标识）。
+type Request struct {
+	URL          string
+	WaitSelector string
+	OutputName   string
+}
+
+// FieldRule 声明一条从表格行中提取字段的规则，而不是在代码里手写 goquery 调用。
+// Selector 在 RowSelector 命中的每一行内部查找；Attr 非空时提取该属性，否则提取文本。
+type FieldRule struct {
+	Name      string
+	Selector  string
+	Attr      string
+	Transform func(string) string
+}
+
+// Spider 是一个站点的声明式抓取规则：起始请求、行选择器和字段规则。
+// 新增站点只需要实现 Spider，不需要改动引擎或 chromedp 调用。
+type Spider interface {
+	// Name 返回 Spider 的唯一标识，用于日志和注册表。
+	Name() string
+	// Requests 返回该 Spider 要抓取的所有请求（例如按日期展开的多页）。
+	Requests() []Request
+	// RowSelector 是在页面 HTML 中定位每一行数据的选择器。
+	RowSelector() string
+	// Fields 声明如何从每一行里提取字段。
+	Fields() []FieldRule
+}
+
+// DateRangeSpider 是按日期参数化请求的 Spider，供 Scheduler 在任意 [start, end]
+// 范围内生成任务，而不必局限于 Spider 自带的默认 Requests()。
+type DateRangeSpider interface {
+	Spider
+	RequestForDate(date time.Time) Request
+}
@@ -0,0 +1,62 @@
+package spiders
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+// matchStartDate/matchEndDate 覆盖巴黎奥运会的完整赛程日期范围。
+const (
+	matchStartDate = "2024-07-24"
+	matchEndDate   = "2024-08-11"
+)
+
+// MatchSpider 声明 CCTV 巴黎奥运会逐日赛程页面的抓取规则。
+type MatchSpider struct{}
+
+// Name 返回 Spider 标识。
+func (MatchSpider) Name() string { return "cctv_match" }
+
+// Requests 按日期展开赛程范围内的每一天，生成对应的请求。
+func (s MatchSpider) Requests() []crawler.Request {
+	start, err := time.Parse("2006-01-02", matchStartDate)
+	if err != nil {
+		log.Fatal(err)
+	}
+	end, err := time.Parse("2006-01-02", matchEndDate)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var requests []crawler.Request
+	for current := start; !current.After(end); current = current.AddDate(0, 0, 1) {
+		requests = append(requests, s.RequestForDate(current))
+	}
+	return requests
+}
+
+// RequestForDate 构造某一天的请求，供 Scheduler 在任意日期范围内按需生成任务使用。
+func (MatchSpider) RequestForDate(date time.Time) crawler.Request {
+	dateStr := date.Format("20060102")
+	return crawler.Request{
+		URL:          fmt.Sprintf("https://sports.cctv.cn/Paris2024/schedule/date/index.shtml?date=%s", dateStr),
+		WaitSelector: "#data_list",
+		OutputName:   fmt.Sprintf("%s_data.json", dateStr),
+	}
+}
+
+// RowSelector 定位赛程表格的每一行。
+func (MatchSpider) RowSelector() string { return "#data_list tr" }
+
+// Fields 声明赛程每一行要提取的字段。
+func (MatchSpider) Fields() []crawler.FieldRule {
+	return []crawler.FieldRule{
+		{Name: "time", Selector: "td:nth-child(1)"},
+		{Name: "sport", Selector: "td:nth-child(3)"},
+		{Name: "name", Selector: "td:nth-child(4)"},
+		{Name: "venue", Selector: "td:nth-child(5)"},
+	}
+}
@@ -0,0 +1,50 @@
+// Package spiders 收录各个站点的 Spider 声明。新增一个站点只需要在这里加一个文件，
+// 不需要改动 crawler 引擎。
+package spiders
+
+import (
+	"strings"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+// MedalSpider 声明 CCTV 巴黎奥运会奖牌榜页面的抓取规则。
+type MedalSpider struct{}
+
+// Name 返回 Spider 标识。
+func (MedalSpider) Name() string { return "cctv_medal" }
+
+// Requests 奖牌榜只有一个页面，直接返回单个请求。
+func (MedalSpider) Requests() []crawler.Request {
+	return []crawler.Request{
+		{
+			URL:          "https://sports.cctv.cn/Paris2024/medal_list/index.shtml?spm=C73465.PkN5JcjBF6mp.E6mpRwlrGbbT.1",
+			WaitSelector: "#medal_list1",
+			OutputName:   "medal_data.json",
+		},
+	}
+}
+
+// RowSelector 定位奖牌榜表格的每一行。
+func (MedalSpider) RowSelector() string { return "#medal_list1 tr" }
+
+// Fields 声明奖牌榜每一行要提取的字段。
+func (MedalSpider) Fields() []crawler.FieldRule {
+	return []crawler.FieldRule{
+		{Name: "rank", Selector: "td:nth-child(1)"},
+		{Name: "country", Selector: "td.country a", Attr: "href", Transform: extractCountryID},
+		{Name: "gold", Selector: "td:nth-child(3)"},
+		{Name: "silver", Selector: "td:nth-child(4)"},
+		{Name: "bronze", Selector: "td:nth-child(5)"},
+		{Name: "total", Selector: "td:nth-child(6)"},
+	}
+}
+
+// extractCountryID 从国家链接中取出 countryid 参数，对应原先的 extractCountryID。
+func extractCountryID(link string) string {
+	parts := strings.Split(link, "countryid=")
+	if len(parts) > 1 {
+		return strings.Split(parts[1], "&")[0]
+	}
+	return ""
+}
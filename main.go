@@ -2,241 +2,126 @@ package main
 
 import (
 	"context"
-	"encoding/json"
+	"flag"
 	"fmt"
 	"log"
-	"os"
-	"strings"
 	"time"
 
-	"github.com/PuerkitoBio/goquery"
-	"github.com/chromedp/chromedp"
+	"github.com/SiwenyuFish/crawler/aggregate"
+	"github.com/SiwenyuFish/crawler/captcha"
+	"github.com/SiwenyuFish/crawler/checkpoint"
+	"github.com/SiwenyuFish/crawler/crawler"
+	"github.com/SiwenyuFish/crawler/crawler/spiders"
+	"github.com/SiwenyuFish/crawler/proxy"
+	"github.com/SiwenyuFish/crawler/scheduler"
+	"github.com/SiwenyuFish/crawler/storage"
 )
 
-// 定义比赛数据的结构
-type MatchData struct {
-	Time  string `json:"time"`
-	Sport string `json:"sport"`
-	Name  string `json:"name"`
-	Venue string `json:"venue"`
+// challengeConfig 声明 CCTV 赛程页面命中反爬挑战时，验证码图片、输入框和提交按钮
+// 的选择器。不同站点的挑战页面结构不同，接入新站点时按需调整这里的选择器。
+var challengeConfig = captcha.Config{
+	ChallengeSelector:   "#captcha_img",
+	AnswerInputSelector: "#captcha_input",
+	SubmitSelector:      "#captcha_submit",
 }
 
-// MedalData 定义奖牌数据的结构
-type MedalData struct {
-	Rank    string `json:"rank"`
-	Country string `json:"country"`
-	Gold    string `json:"gold"`
-	Silver  string `json:"silver"`
-	Bronze  string `json:"bronze"`
-	Total   string `json:"total"`
+// paris2024 描述本仓库唯一抓取的这一届赛事，供 aggregate 包把奖牌榜记成时间序列。
+var paris2024 = aggregate.Tournament{
+	ID:        "paris2024",
+	Name:      "Paris 2024 Summer Olympics",
+	StartDate: "2024-07-24",
+	EndDate:   "2024-08-11",
 }
 
-func main() {
-	// 爬取奖牌数据
-	if err := fetchMedalData(); err != nil {
-		log.Fatal(err)
-	}
+const matchConcurrency = 4
 
-	// 爬取比赛数据
-	if err := fetchMatchData(); err != nil {
+func main() {
+	storeKind := flag.String("store", "json", "数据落地方式：json 或 mysql")
+	mysqlDSN := flag.String("dsn", "", "--store=mysql 时使用的 MySQL DSN，例如 user:pass@tcp(127.0.0.1:3306)/crawler")
+	checkpointDB := flag.String("checkpoint-db", "checkpoint.bolt", "检查点数据库文件路径")
+	checkpointTTL := flag.Duration("checkpoint-ttl", time.Hour, "检查点有效期，未过期时跳过整次抓取")
+	proxyCfgPath := flag.String("proxy-cfg", "", "代理列表和打码平台账号配置文件，留空则不启用代理轮换和验证码识别")
+	flag.Parse()
+
+	basePipeline, standingStore, err := newPipeline(*storeKind, *mysqlDSN)
+	if err != nil {
 		log.Fatal(err)
 	}
-}
 
-// 爬取并保存奖牌数据
-func fetchMedalData() error {
-	// 创建Chromedp上下文
-	ctx, cancel := chromedp.NewContext(context.Background())
-	defer cancel()
-
-	// 设置超时上下文
-	ctx, cancel = context.WithTimeout(ctx, 60*time.Second)
-	defer cancel()
-
-	// 目标网页
-	url := "https://sports.cctv.cn/Paris2024/medal_list/index.shtml?spm=C73465.PkN5JcjBF6mp.E6mpRwlrGbbT.1"
-
-	var htmlContent string
-
-	// 启动浏览器并抓取页面的HTML内容
-	err := chromedp.Run(ctx,
-		chromedp.Navigate(url),
-		chromedp.WaitReady("#medal_list1"),
-		chromedp.OuterHTML("body", &htmlContent),
-	)
+	cpStore, err := checkpoint.Open(*checkpointDB)
 	if err != nil {
-		return err
+		log.Fatal(err)
 	}
-
-	// 解析抓取到的HTML并提取数据
-	medalData := extractMedalData(htmlContent)
-
-	// 将奖牌数据转换为JSON格式并保存到文件
-	return saveToJSON(medalData, "medal_data.json")
-}
-
-// 提取奖牌数据
-func extractMedalData(htmlContent string) []MedalData {
-	var medalList []MedalData
-
-	// 解析HTML内容
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
-	if err != nil {
+	defer cpStore.Close()
+
+	ctx := context.Background()
+	freshness := checkpoint.NewGate(cpStore, *checkpointTTL)
+
+	// 奖牌榜按 country 匹配新旧两次抓取的行，每次保存后再记一条 Standing 快照，
+	// 这样奖牌榜就是一条随赛程推进的时间序列，而不是只有最新一份。standingStore 跟
+	// basePipeline 用同一个 --store 选择，--store=mysql 时两者落到同一个数据库。
+	// Freshness 在检查点还没过期时直接跳过整次抓取，而不只是跳过保存。
+	aggregator := aggregate.NewAggregator(paris2024, standingStore)
+	medalPipeline := checkpoint.NewPipeline(aggregate.NewPipeline(basePipeline, aggregator), cpStore, medalRowKey)
+	engine := crawler.NewEngine(crawler.NewChromedpFetcher(0), medalPipeline)
+	engine.Freshness = freshness
+	if err := engine.Run(ctx, spiders.MedalSpider{}); err != nil {
 		log.Fatal(err)
 	}
 
-	// 查找表格中的每一行数据
-	doc.Find("#medal_list1 tr").Each(func(i int, row *goquery.Selection) {
-		rank := row.Find("td").Eq(0).Text()
-		countryLink, _ := row.Find("td.country a").Attr("href") // 提取链接
-		countryID := extractCountryID(countryLink)              // 从链接中提取 countryid
-		gold := row.Find("td").Eq(2).Text()
-		silver := row.Find("td").Eq(3).Text()
-		bronze := row.Find("td").Eq(4).Text()
-		total := row.Find("td").Eq(5).Text()
-
-		// 清理数据
-		rank = strings.TrimSpace(rank)
-		countryID = strings.TrimSpace(countryID)
-		gold = strings.TrimSpace(gold)
-		silver = strings.TrimSpace(silver)
-		bronze = strings.TrimSpace(bronze)
-		total = strings.TrimSpace(total)
-
-		// 创建MedalData对象并添加到列表
-		if rank != "" {
-			medalData := MedalData{
-				Rank:    rank,
-				Country: countryID, // 使用提取到的 countryid
-				Gold:    gold,
-				Silver:  silver,
-				Bronze:  bronze,
-				Total:   total,
-			}
-			medalList = append(medalList, medalData)
+	// 赛程按 time+name 匹配新旧两次抓取的行。
+	matchPipeline := checkpoint.NewPipeline(basePipeline, cpStore, matchRowKey)
+	matchScheduler := scheduler.NewScheduler(spiders.MatchSpider{}, matchPipeline)
+	matchScheduler.Freshness = freshness
+	if *proxyCfgPath != "" {
+		proxyPool, captchaInterceptor, err := newAntiBot(*proxyCfgPath)
+		if err != nil {
+			log.Fatal(err)
 		}
-	})
-
-	return medalList
-}
-
-// 从链接中提取 countryid
-func extractCountryID(link string) string {
-	// 查找 "countryid=" 后面的值
-	parts := strings.Split(link, "countryid=")
-	if len(parts) > 1 {
-		return strings.Split(parts[1], "&")[0]
+		matchScheduler.ProxyPool = proxyPool
+		matchScheduler.Captcha = captchaInterceptor
+	}
+	if err := matchScheduler.Run(ctx, "2024-07-24", "2024-08-11", matchConcurrency); err != nil {
+		log.Fatal(err)
 	}
-	return ""
 }
 
-// 爬取并保存比赛数据
-func fetchMatchData() error {
-	startDate := "2024-07-24"
-	endDate := "2024-08-11"
-
-	// 解析日期
-	start, err := time.Parse("2006-01-02", startDate)
-	if err != nil {
-		return err
-	}
-	end, err := time.Parse("2006-01-02", endDate)
+// newAntiBot 从 proxy_cfg.json 加载代理列表和打码平台账号，构造代理轮换池和
+// 反爬挑战拦截器。
+func newAntiBot(path string) (*proxy.Pool, *captcha.Interceptor, error) {
+	cfg, err := proxy.LoadConfig(path)
 	if err != nil {
-		return err
+		return nil, nil, err
 	}
 
-	for current := start; !current.After(end); current = current.AddDate(0, 0, 1) {
-		dateStr := current.Format("20060102") // 格式化为 yyyyMMdd
-		fileName := fmt.Sprintf("%s_data.json", dateStr)
-		url := fmt.Sprintf("https://sports.cctv.cn/Paris2024/schedule/date/index.shtml?date=%s", dateStr)
-
-		// 创建Chromedp上下文
-		ctx, cancel := chromedp.NewContext(context.Background())
-		defer cancel()
-
-		// 设置超时上下文
-		ctx, cancel = context.WithTimeout(ctx, 60*time.Second)
-		defer cancel()
-
-		var htmlContent string
+	pool := proxy.NewPool(cfg.Proxies)
+	solver := captcha.NewChaoJiYingSolver(cfg.Solver.Username, cfg.Solver.Password, cfg.Solver.SoftID)
+	interceptor := captcha.NewInterceptor(solver, challengeConfig)
 
-		// 启动浏览器并抓取页面的HTML内容
-		err := chromedp.Run(ctx,
-			chromedp.Navigate(url),
-			chromedp.WaitReady("#data_list"),
-			chromedp.OuterHTML("body", &htmlContent),
-		)
-		if err != nil {
-			log.Printf("Failed to fetch data for date %s: %v", dateStr, err)
-			continue // 失败时继续下一天的抓取
-		}
-
-		// 解析抓取到的HTML并提取数据
-		matchData := extractMatchData(htmlContent)
+	return pool, interceptor, nil
+}
 
-		// 将比赛数据转换为JSON格式并保存到文件
-		err = saveToJSON(matchData, fileName)
+// newPipeline 按 --store 参数选择落地方式，同时返回对应后端的 StandingStore，
+// 这样 --store=mysql 时奖牌榜时间序列也落到同一个数据库，而不是总是写 JSON 文件。
+func newPipeline(storeKind, mysqlDSN string) (crawler.Pipeline, aggregate.StandingStore, error) {
+	switch storeKind {
+	case "json":
+		return storage.NewPipeline(storage.JSONStore{}), aggregate.NewJSONStandingStore("standings_history.json"), nil
+	case "mysql":
+		store, err := storage.NewMySQLStore(mysqlDSN)
 		if err != nil {
-			log.Printf("Failed to save data for date %s: %v", dateStr, err)
-			continue // 失败时继续下一天的保存
+			return nil, nil, err
 		}
-
-		fmt.Printf("数据已成功保存到 %s 文件中\n", fileName)
+		return storage.NewPipeline(store), store, nil
+	default:
+		return nil, nil, fmt.Errorf("未知的 --store 取值 %q，支持 json 或 mysql", storeKind)
 	}
-
-	return nil
 }
 
-// 提取比赛数据
-func extractMatchData(htmlContent string) []MatchData {
-	var matchList []MatchData
-
-	// 解析HTML内容
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(htmlContent))
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	// 查找表格中的每一行数据
-	doc.Find("#data_list tr").Each(func(i int, row *goquery.Selection) {
-		time := row.Find("td").Eq(0).Text()
-		sport := row.Find("td").Eq(2).Text()
-		name := row.Find("td").Eq(3).Text()
-		venue := row.Find("td").Eq(4).Text()
-
-		// 清理数据
-		time = strings.TrimSpace(time)
-		sport = strings.TrimSpace(sport)
-		name = strings.TrimSpace(name)
-		venue = strings.TrimSpace(venue)
-
-		// 创建MatchData对象并添加到列表
-		if time != "" {
-			matchData := MatchData{
-				Time:  time,
-				Sport: sport,
-				Name:  name,
-				Venue: venue,
-			}
-			matchList = append(matchList, matchData)
-		}
-	})
-
-	return matchList
+func medalRowKey(row crawler.Row) string {
+	return row["country"]
 }
 
-// 将数据保存为JSON文件
-func saveToJSON(data interface{}, filename string) error {
-	// 创建文件
-	file, err := os.Create(filename)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	// 将数据编码为JSON
-	encoder := json.NewEncoder(file)
-	encoder.SetIndent("", "  ") // 格式化输出
-
-	return encoder.Encode(data)
+func matchRowKey(row crawler.Row) string {
+	return row["time"] + "|" + row["name"]
 }
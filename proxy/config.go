@@ -0,0 +1,34 @@
+package proxy
+
+import (
+	"encoding/json"
+	"os"
+)
+
+// Config 是 proxy_cfg.json 的结构：一份代理列表加一份打码平台账号，
+// 用户在不改代码的情况下接入自己的代理和验证码识别凭据。
+type Config struct {
+	Proxies []string     `json:"proxies"`
+	Solver  SolverConfig `json:"solver"`
+}
+
+// SolverConfig 是打码平台的账号信息，对应 captcha.ChaoJiYingSolver 的构造参数。
+type SolverConfig struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	SoftID   string `json:"soft_id"`
+}
+
+// LoadConfig 读取并解析 proxy_cfg.json。
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, err
+	}
+	return cfg, nil
+}
@@ -0,0 +1,44 @@
+// Package proxy 提供一个简单的代理轮换池，供 Scheduler 在反复抓取失败时切换出口 IP。
+package proxy
+
+import (
+	"sync"
+
+	"github.com/chromedp/chromedp"
+)
+
+// Pool 按顺序轮换一组 "--proxy-server" 值，用于在命中反爬限制后更换出口 IP。
+type Pool struct {
+	mu      sync.Mutex
+	proxies []string
+	next    int
+}
+
+// NewPool 创建一个代理轮换池，proxies 为空时 Next 返回空字符串（不使用代理）。
+func NewPool(proxies []string) *Pool {
+	return &Pool{proxies: proxies}
+}
+
+// Next 按轮询顺序返回下一个代理地址。
+func (p *Pool) Next() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if len(p.proxies) == 0 {
+		return ""
+	}
+
+	proxyAddr := p.proxies[p.next%len(p.proxies)]
+	p.next++
+	return proxyAddr
+}
+
+// AllocatorOption 返回下一个代理对应的 chromedp ExecAllocator 选项，
+// 池为空时返回 nil，调用方应跳过该选项。
+func (p *Pool) AllocatorOption() chromedp.ExecAllocatorOption {
+	proxyAddr := p.Next()
+	if proxyAddr == "" {
+		return nil
+	}
+	return chromedp.ProxyServer(proxyAddr)
+}
@@ -0,0 +1,184 @@
+// Package scheduler 提供按日期范围并发抓取的调度器，共享同一个 chromedp 浏览器分配器，
+// 避免像原先的 fetchMatchData 那样每一天都重新启动一个浏览器进程。
+package scheduler
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/chromedp/chromedp"
+
+	"github.com/SiwenyuFish/crawler/captcha"
+	"github.com/SiwenyuFish/crawler/crawler"
+	"github.com/SiwenyuFish/crawler/proxy"
+)
+
+const (
+	defaultTaskTimeout = 60 * time.Second
+	defaultMaxRetries  = 2
+	defaultBaseBackoff = 2 * time.Second
+)
+
+// Scheduler 针对某个 DateRangeSpider，用固定数量的 worker 并发抓取一段日期范围，
+// 所有 worker 共享同一个 chromedp.ExecAllocator，从而把浏览器 tab 数量限制在可控范围内。
+type Scheduler struct {
+	Spider   crawler.DateRangeSpider
+	Pipeline crawler.Pipeline
+
+	TaskTimeout time.Duration
+	MaxRetries  int
+	BaseBackoff time.Duration
+
+	// Captcha 在配置后，会在每次导航后检查目标选择器是否就绪，未就绪时尝试识别并
+	// 过掉挑战页面。为 nil 表示不做任何反爬检测。
+	Captcha *captcha.Interceptor
+	// ProxyPool 在配置后，会在某个任务重试时更换出口代理，而不是继续复用同一个
+	// 被限流/封禁的出口。为 nil 表示不做代理轮换。
+	ProxyPool *proxy.Pool
+
+	// Freshness 在配置后，会在每天的任务派发前问一遍目标是否仍在检查点有效期内，
+	// 为 nil 表示不做这个检查，每天都抓取。
+	Freshness crawler.FreshnessChecker
+}
+
+// NewScheduler 创建一个使用默认超时和重试参数的 Scheduler。
+func NewScheduler(spider crawler.DateRangeSpider, pipeline crawler.Pipeline) *Scheduler {
+	return &Scheduler{
+		Spider:      spider,
+		Pipeline:    pipeline,
+		TaskTimeout: defaultTaskTimeout,
+		MaxRetries:  defaultMaxRetries,
+		BaseBackoff: defaultBaseBackoff,
+	}
+}
+
+// Run 并发抓取 [startDate, endDate] 范围内的每一天，concurrency 控制同时工作的 worker 数量，
+// 也就是同时打开的浏览器 tab 数量上限。
+func (s *Scheduler) Run(ctx context.Context, startDate, endDate string, concurrency int) error {
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return err
+	}
+	end, err := time.Parse("2006-01-02", endDate)
+	if err != nil {
+		return err
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	// 整个 Run 期间默认只分配一次浏览器进程，每个任务只派生一个子 context；
+	// 只有在某个任务重试且配置了 ProxyPool 时，才会临时分配一个带新代理的浏览器。
+	allocCtx, cancel := chromedp.NewExecAllocator(ctx, chromedp.DefaultExecAllocatorOptions[:]...)
+	defer cancel()
+
+	dateCh := make(chan time.Time)
+	done := make(chan struct{}, concurrency)
+	for i := 0; i < concurrency; i++ {
+		go s.worker(ctx, allocCtx, dateCh, done)
+	}
+
+	for current := start; !current.After(end); current = current.AddDate(0, 0, 1) {
+		dateCh <- current
+	}
+	close(dateCh)
+
+	for i := 0; i < concurrency; i++ {
+		<-done
+	}
+	return nil
+}
+
+// worker 不断从 dateCh 取日期，直到 channel 关闭。
+func (s *Scheduler) worker(rootCtx, allocCtx context.Context, dateCh <-chan time.Time, done chan<- struct{}) {
+	defer func() { done <- struct{}{} }()
+	for date := range dateCh {
+		req := s.Spider.RequestForDate(date)
+
+		if s.Freshness != nil {
+			fresh, err := s.Freshness.IsFresh(req.OutputName)
+			if err != nil {
+				log.Printf("[%s] 检查点查询失败 %s: %v", s.Spider.Name(), req.OutputName, err)
+			} else if fresh {
+				log.Printf("[%s] %s 仍在检查点有效期内，跳过抓取", s.Spider.Name(), req.OutputName)
+				continue
+			}
+		}
+
+		if err := s.runWithRetry(rootCtx, allocCtx, req); err != nil {
+			log.Printf("[%s] %s 最终失败: %v", s.Spider.Name(), req.OutputName, err)
+		}
+	}
+}
+
+// runWithRetry 对单个任务做带指数退避的有限次重试。从第一次重试开始，如果配置了
+// ProxyPool，就换一个出口代理而不是继续用被限流的那个。
+func (s *Scheduler) runWithRetry(rootCtx, allocCtx context.Context, req crawler.Request) error {
+	backoff := s.BaseBackoff
+	var err error
+	for attempt := 0; attempt <= s.MaxRetries; attempt++ {
+		taskAllocCtx := allocCtx
+		var cancel context.CancelFunc = func() {}
+
+		if attempt > 0 {
+			log.Printf("[%s] 第 %d 次重试 %s", s.Spider.Name(), attempt, req.OutputName)
+			time.Sleep(backoff)
+			backoff *= 2
+
+			if s.ProxyPool != nil {
+				taskAllocCtx, cancel = s.allocatorWithProxy(rootCtx)
+			}
+		}
+
+		err = s.runOnce(taskAllocCtx, req)
+		cancel()
+		if err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+// allocatorWithProxy 基于 rootCtx 分配一个带有 ProxyPool 下一个代理地址的浏览器进程，
+// 只在重试时临时使用，完成后由调用方 cancel 掉。
+func (s *Scheduler) allocatorWithProxy(rootCtx context.Context) (context.Context, context.CancelFunc) {
+	opts := append(chromedp.DefaultExecAllocatorOptions[:0:0], chromedp.DefaultExecAllocatorOptions[:]...)
+	if opt := s.ProxyPool.AllocatorOption(); opt != nil {
+		opts = append(opts, opt)
+	}
+	return chromedp.NewExecAllocator(rootCtx, opts...)
+}
+
+// runOnce 在共享分配器上派生一个带超时的子 context，抓取、解析并保存单个任务。
+func (s *Scheduler) runOnce(allocCtx context.Context, req crawler.Request) error {
+	taskCtx, cancel := chromedp.NewContext(allocCtx)
+	defer cancel()
+
+	taskCtx, cancel = context.WithTimeout(taskCtx, s.TaskTimeout)
+	defer cancel()
+
+	if err := chromedp.Run(taskCtx, chromedp.Navigate(req.URL)); err != nil {
+		return err
+	}
+
+	if s.Captcha != nil {
+		if err := s.Captcha.HandleIfChallenged(taskCtx, req.WaitSelector); err != nil {
+			return err
+		}
+	} else if err := chromedp.Run(taskCtx, chromedp.WaitReady(req.WaitSelector)); err != nil {
+		return err
+	}
+
+	var htmlContent string
+	if err := chromedp.Run(taskCtx, chromedp.OuterHTML("body", &htmlContent)); err != nil {
+		return err
+	}
+
+	rows, err := crawler.ParseRows(htmlContent, s.Spider.RowSelector(), s.Spider.Fields())
+	if err != nil {
+		return err
+	}
+
+	return s.Pipeline.Save(req.OutputName, rows)
+}
@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+)
+
+// JSONStore 把数据写成格式化的 JSON 文件，对应重构前的 saveToJSON 行为。
+type JSONStore struct{}
+
+// SaveMedals 把奖牌榜写入 medal_data.json。
+func (JSONStore) SaveMedals(records []MedalData) error {
+	return writeJSON("medal_data.json", records)
+}
+
+// SaveMatches 把某一天的赛程写入 <date>_data.json。
+func (JSONStore) SaveMatches(date string, records []MatchData) error {
+	return writeJSON(fmt.Sprintf("%s_data.json", date), records)
+}
+
+func writeJSON(filename string, data interface{}) error {
+	file, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(data)
+}
@@ -0,0 +1,66 @@
+package storage
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// withTempCWD 切到一个临时目录执行 fn，JSONStore 总是写到当前工作目录下的相对文件名。
+func withTempCWD(t *testing.T, fn func(dir string)) {
+	t.Helper()
+	dir := t.TempDir()
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(cwd)
+
+	fn(dir)
+}
+
+func TestJSONStore_SaveMedalsRoundTrips(t *testing.T) {
+	withTempCWD(t, func(dir string) {
+		records := []MedalData{{Rank: "1", Country: "USA", Gold: "40", Silver: "44", Bronze: "42", Total: "126"}}
+		if err := (JSONStore{}).SaveMedals(records); err != nil {
+			t.Fatalf("SaveMedals returned error: %v", err)
+		}
+
+		var got []MedalData
+		readJSONFile(t, filepath.Join(dir, "medal_data.json"), &got)
+		if len(got) != 1 || got[0].Country != "USA" {
+			t.Fatalf("unexpected contents: %+v", got)
+		}
+	})
+}
+
+func TestJSONStore_SaveMatchesUsesDateInFilename(t *testing.T) {
+	withTempCWD(t, func(dir string) {
+		records := []MatchData{{Time: "10:00", Sport: "游泳", Name: "男子100米自由泳", Venue: "巴黎水上中心"}}
+		if err := (JSONStore{}).SaveMatches("20240727", records); err != nil {
+			t.Fatalf("SaveMatches returned error: %v", err)
+		}
+
+		var got []MatchData
+		readJSONFile(t, filepath.Join(dir, "20240727_data.json"), &got)
+		if len(got) != 1 || got[0].Sport != "游泳" {
+			t.Fatalf("unexpected contents: %+v", got)
+		}
+	})
+}
+
+func readJSONFile(t *testing.T, path string, out interface{}) {
+	t.Helper()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading %s: %v", path, err)
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		t.Fatalf("unmarshaling %s: %v", path, err)
+	}
+}
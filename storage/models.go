@@ -0,0 +1,30 @@
+// Package storage 负责把抓取到的行数据持久化，支持 JSON 文件和 MySQL 两种落地方式。
+package storage
+
+// MedalData 是奖牌榜的持久化模型，country 唯一，重复抓取时按 country upsert。
+type MedalData struct {
+	ID      int64  `xorm:"pk autoincr" json:"-"`
+	Rank    string `xorm:"varchar(16)" json:"rank"`
+	Country string `xorm:"varchar(64) notnull unique 'country'" json:"country"`
+	Gold    string `xorm:"varchar(16)" json:"gold"`
+	Silver  string `xorm:"varchar(16)" json:"silver"`
+	Bronze  string `xorm:"varchar(16)" json:"bronze"`
+	Total   string `xorm:"varchar(16)" json:"total"`
+}
+
+// TableName 指定 MedalData 对应的表名。
+func (MedalData) TableName() string { return "medal_data" }
+
+// MatchData 是单场赛事的持久化模型，(date, sport, name, venue) 联合唯一，
+// 重复抓取同一天同一场赛事时按该组合 upsert。
+type MatchData struct {
+	ID    int64  `xorm:"pk autoincr" json:"-"`
+	Date  string `xorm:"varchar(8) notnull unique(UQE_match_data) 'date'" json:"date"`
+	Time  string `xorm:"varchar(16)" json:"time"`
+	Sport string `xorm:"varchar(64) notnull unique(UQE_match_data)" json:"sport"`
+	Name  string `xorm:"varchar(255) notnull unique(UQE_match_data)" json:"name"`
+	Venue string `xorm:"varchar(255) notnull unique(UQE_match_data)" json:"venue"`
+}
+
+// TableName 指定 MatchData 对应的表名。
+func (MatchData) TableName() string { return "match_data" }
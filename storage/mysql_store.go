@@ -0,0 +1,115 @@
+package storage
+
+import (
+	_ "github.com/go-sql-driver/mysql"
+	"xorm.io/xorm"
+
+	"github.com/SiwenyuFish/crawler/aggregate"
+)
+
+// MySQLStore 用 xorm 把数据落到 MySQL，按唯一索引 upsert 而不是每次追加，
+// 这样重复运行爬虫不会产生重复行。它同时实现 Store 和 aggregate.StandingStore，
+// 这样 --store=mysql 时奖牌榜时间序列也落到同一个数据库，而不是继续写 JSON 文件。
+type MySQLStore struct {
+	Engine *xorm.Engine
+}
+
+// NewMySQLStore 连接 MySQL 并确保 MedalData / MatchData / Standing 对应的表存在。
+func NewMySQLStore(dsn string) (*MySQLStore, error) {
+	engine, err := xorm.NewEngine("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := engine.Sync2(new(MedalData), new(MatchData), new(aggregate.Standing)); err != nil {
+		return nil, err
+	}
+
+	return &MySQLStore{Engine: engine}, nil
+}
+
+// SaveMedals 按 Country upsert 每一条奖牌榜记录。
+func (s *MySQLStore) SaveMedals(records []MedalData) error {
+	for _, record := range records {
+		existing := MedalData{Country: record.Country}
+		ok, err := s.Engine.Get(&existing)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			record.ID = existing.ID
+			if _, err := s.Engine.ID(existing.ID).Update(&record); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := s.Engine.Insert(&record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveMatches 按 (Date, Sport, Name, Venue) upsert 某一天的每一条赛程记录。
+func (s *MySQLStore) SaveMatches(date string, records []MatchData) error {
+	for _, record := range records {
+		record.Date = date
+
+		existing := MatchData{Date: record.Date, Sport: record.Sport, Name: record.Name, Venue: record.Venue}
+		ok, err := s.Engine.Get(&existing)
+		if err != nil {
+			return err
+		}
+
+		if ok {
+			record.ID = existing.ID
+			if _, err := s.Engine.ID(existing.ID).Update(&record); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if _, err := s.Engine.Insert(&record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveSnapshot 实现 aggregate.StandingStore：按 (TournamentID, Country) 查出上一条
+// 快照算出增量，再把这批新快照原样插入，不做 upsert —— Standing 的唯一索引本就包含
+// CapturedAt，同一时间点重复抓取会被索引挡住，不同时间点需要各自保留一行。
+func (s *MySQLStore) SaveSnapshot(standings []aggregate.Standing) ([]aggregate.Delta, error) {
+	deltas := make([]aggregate.Delta, 0, len(standings))
+	for _, standing := range standings {
+		var prev aggregate.Standing
+		found, err := s.Engine.Where("tournament_id = ? AND country = ?", standing.TournamentID, standing.Country).
+			Desc("captured_at").Get(&prev)
+		if err != nil {
+			return nil, err
+		}
+
+		delta := aggregate.Delta{
+			TournamentID: standing.TournamentID,
+			Country:      standing.Country,
+			CapturedAt:   standing.CapturedAt,
+		}
+		if found {
+			delta.GoldGained = standing.Gold - prev.Gold
+			delta.SilverGained = standing.Silver - prev.Silver
+			delta.BronzeGained = standing.Bronze - prev.Bronze
+		} else {
+			delta.GoldGained = standing.Gold
+			delta.SilverGained = standing.Silver
+			delta.BronzeGained = standing.Bronze
+		}
+
+		if _, err := s.Engine.Insert(&standing); err != nil {
+			return nil, err
+		}
+		deltas = append(deltas, delta)
+	}
+	return deltas, nil
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/SiwenyuFish/crawler/crawler"
+)
+
+// Pipeline 把一个 Store 适配成 crawler.Pipeline，让 Spider 不需要关心数据最终
+// 落到 JSON 文件还是 MySQL，只需要照旧把行数据交给 outputName 对应的目标。
+type Pipeline struct {
+	Store Store
+}
+
+// NewPipeline 用给定的 Store 创建一个 crawler.Pipeline 适配器。
+func NewPipeline(store Store) Pipeline {
+	return Pipeline{Store: store}
+}
+
+// Save 按 outputName 判断这批行属于奖牌榜还是某一天的赛程，转换成对应的
+// 类型化记录后交给 Store。
+func (p Pipeline) Save(outputName string, rows []crawler.Row) error {
+	if outputName == "medal_data.json" {
+		return p.Store.SaveMedals(toMedalData(rows))
+	}
+
+	if date, ok := matchDate(outputName); ok {
+		return p.Store.SaveMatches(date, toMatchData(rows))
+	}
+
+	return fmt.Errorf("storage: 无法识别的输出目标 %q", outputName)
+}
+
+// matchDate 从 "<date>_data.json" 里取出日期部分。
+func matchDate(outputName string) (string, bool) {
+	const suffix = "_data.json"
+	if outputName == "medal_data.json" || !strings.HasSuffix(outputName, suffix) {
+		return "", false
+	}
+	return strings.TrimSuffix(outputName, suffix), true
+}
+
+func toMedalData(rows []crawler.Row) []MedalData {
+	records := make([]MedalData, 0, len(rows))
+	for _, row := range rows {
+		records = append(records, MedalData{
+			Rank:    row["rank"],
+			Country: row["country"],
+			Gold:    row["gold"],
+			Silver:  row["silver"],
+			Bronze:  row["bronze"],
+			Total:   row["total"],
+		})
+	}
+	return records
+}
+
+func toMatchData(rows []crawler.Row) []MatchData {
+	records := make([]MatchData, 0, len(rows))
+	for _, row := range rows {
+		records = append(records, MatchData{
+			Time:  row["time"],
+			Sport: row["sport"],
+			Name:  row["name"],
+			Venue: row["venue"],
+		})
+	}
+	return records
+}
@@ -0,0 +1,10 @@
+package storage
+
+// Store 是数据落地的统一接口，JSONStore 和 MySQLStore 各自实现一种后端，
+// 通过 --store 参数切换，互不影响上层的抓取逻辑。
+type Store interface {
+	// SaveMedals 保存奖牌榜快照，按 Country 去重/更新。
+	SaveMedals(records []MedalData) error
+	// SaveMatches 保存某一天的赛程，按 (Date, Sport, Name, Venue) 去重/更新。
+	SaveMatches(date string, records []MatchData) error
+}